@@ -0,0 +1,113 @@
+package rules
+
+// Point represents the position of a single square on the board, with (0, 0)
+// being the bottom-left corner.
+type Point struct {
+	X int32
+	Y int32
+}
+
+// BoardState represents the entire state of a game board at a single point
+// in time. It is immutable once constructed: methods on a Ruleset return a
+// new BoardState rather than modifying an existing one in place.
+type BoardState struct {
+	Height  int32
+	Width   int32
+	Turn    int32
+	Food    []Food
+	Snakes  []Snake
+	Hazards []Point
+}
+
+// Snake represents the state of a single snake on the board.
+type Snake struct {
+	ID              string
+	Health          int32
+	Body            []Point
+	EliminatedCause string
+	EliminatedBy    string
+
+	// Squad is the identifier shared by teammates under SquadRuleset. It is
+	// empty for rulesets that don't group snakes into squads.
+	Squad string
+
+	// Score accumulates the Points value of every FoodVariant the snake
+	// has eaten, so clients can rank snakes by points rather than just
+	// survival.
+	Score int32
+}
+
+// Move is a single requested direction of travel for a snake.
+type Move string
+
+const (
+	MoveUp    = Move("up")
+	MoveDown  = Move("down")
+	MoveLeft  = Move("left")
+	MoveRight = Move("right")
+)
+
+// SnakeMove pairs a snake ID with the move it has requested for the current
+// turn.
+type SnakeMove struct {
+	ID   string
+	Move Move
+}
+
+// Clone returns a deep copy of b, so that the returned BoardState can be
+// mutated (or handed to a Ruleset's simulation helpers) without affecting b.
+func (b *BoardState) Clone() *BoardState {
+	clone := &BoardState{
+		Height:  b.Height,
+		Width:   b.Width,
+		Turn:    b.Turn,
+		Food:    append([]Food{}, b.Food...),
+		Hazards: append([]Point{}, b.Hazards...),
+		Snakes:  make([]Snake, len(b.Snakes)),
+	}
+	for i, snake := range b.Snakes {
+		clone.Snakes[i] = snake
+		clone.Snakes[i].Body = append([]Point{}, snake.Body...)
+	}
+	return clone
+}
+
+// nextBoardStateSkeleton copies the fields of prevState that every Ruleset's
+// CreateNextBoardState carries forward unconditionally (board size, turn
+// counter, food, hazards, and each snake's identity/health/body/squad/score),
+// without touching prevState. Callers apply their own turn resolution
+// (movement, feeding, elimination, ...) to the result; a ruleset that
+// doesn't carry some field forward as-is (e.g. ConstrictorRuleset resets
+// health and never spawns food) overwrites it afterward.
+//
+// Factored out so that Royale/Squad/Constrictor/Wrapped's CreateNextBoardState
+// overrides, which can't reach StandardRuleset's via embedding (Go doesn't
+// dispatch through it), can't drift from each other on this shared scaffolding.
+func nextBoardStateSkeleton(prevState *BoardState) *BoardState {
+	nextState := &BoardState{
+		Height:  prevState.Height,
+		Width:   prevState.Width,
+		Turn:    prevState.Turn + 1,
+		Food:    append([]Food{}, prevState.Food...),
+		Hazards: append([]Point{}, prevState.Hazards...),
+		Snakes:  make([]Snake, len(prevState.Snakes)),
+	}
+	for i, snake := range prevState.Snakes {
+		nextState.Snakes[i].ID = snake.ID
+		nextState.Snakes[i].Health = snake.Health
+		nextState.Snakes[i].Body = append([]Point{}, snake.Body...)
+		nextState.Snakes[i].Squad = snake.Squad
+		nextState.Snakes[i].Score = snake.Score
+	}
+	return nextState
+}
+
+// containsPoint reports whether p appears in points.
+func containsPoint(points []Point, p Point) bool {
+	for _, point := range points {
+		if point.X == p.X && point.Y == p.Y {
+			return true
+		}
+	}
+	return false
+}
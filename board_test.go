@@ -0,0 +1,36 @@
+package rules
+
+import "testing"
+
+func TestBoardStateCloneIsIndependent(t *testing.T) {
+	original := &BoardState{
+		Width:   5,
+		Height:  5,
+		Food:    []Food{{Point: Point{1, 1}, Variant: DefaultFoodVariant}},
+		Hazards: []Point{{0, 0}},
+		Snakes:  []Snake{{ID: "a", Health: 50, Body: []Point{{2, 2}, {2, 3}}}},
+	}
+
+	clone := original.Clone()
+	clone.Food[0].Variant = "mutated"
+	clone.Hazards[0] = Point{4, 4}
+	clone.Snakes[0].Health = 1
+	clone.Snakes[0].Body[0] = Point{0, 0}
+	clone.Snakes = append(clone.Snakes, Snake{ID: "b"})
+
+	if original.Food[0].Variant != DefaultFoodVariant {
+		t.Error("mutating the clone's Food affected the original")
+	}
+	if original.Hazards[0] != (Point{0, 0}) {
+		t.Error("mutating the clone's Hazards affected the original")
+	}
+	if original.Snakes[0].Health != 50 {
+		t.Error("mutating the clone's Snake.Health affected the original")
+	}
+	if original.Snakes[0].Body[0] != (Point{2, 2}) {
+		t.Error("mutating the clone's Snake.Body affected the original")
+	}
+	if len(original.Snakes) != 1 {
+		t.Error("appending to the clone's Snakes affected the original")
+	}
+}
@@ -0,0 +1,137 @@
+package rules
+
+import "errors"
+
+// ConstrictorRuleset is the Battlesnake Constrictor variant: snakes never
+// lose their tails, so every move grows them by one, and no food is ever
+// spawned or consumed.
+type ConstrictorRuleset struct {
+	StandardRuleset
+}
+
+// NewConstrictorRuleset constructs a ConstrictorRuleset.
+func NewConstrictorRuleset() *ConstrictorRuleset {
+	return &ConstrictorRuleset{}
+}
+
+// Name implements Ruleset.
+func (r *ConstrictorRuleset) Name() string { return "constrictor" }
+
+// CreateInitialBoardState places snakes as usual, but never spawns food:
+// under Constrictor rules every square is effectively food.
+func (r *ConstrictorRuleset) CreateInitialBoardState(width int32, height int32, snakeIDs []string) (*BoardState, error) {
+	initialBoardState := &BoardState{
+		Height: height,
+		Width:  width,
+		Snakes: make([]Snake, len(snakeIDs)),
+	}
+
+	for i := 0; i < len(snakeIDs); i++ {
+		initialBoardState.Snakes[i] = Snake{
+			ID:     snakeIDs[i],
+			Health: SnakeMaxHealth,
+		}
+	}
+
+	if err := r.placeSnakes(initialBoardState); err != nil {
+		return nil, err
+	}
+
+	return initialBoardState, nil
+}
+
+func (r *ConstrictorRuleset) CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error) {
+	// Constrictor never spawns food and never loses health, so override
+	// those two fields of the shared skeleton.
+	nextState := nextBoardStateSkeleton(prevState)
+	nextState.Food = nil
+	for i := range nextState.Snakes {
+		nextState.Snakes[i].Health = SnakeMaxHealth
+	}
+
+	var events []TurnEvent
+
+	moveEvents, err := r.growingMoveSnakes(nextState, moves)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, moveEvents...)
+
+	eliminateEvents, err := r.maybeEliminateSnakes(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, eliminateEvents...)
+
+	return nextState, events, nil
+}
+
+// growingMoveSnakes is identical to StandardRuleset.moveSnakes, except the
+// tail is never popped, so each snake grows by one segment every turn, and a
+// SnakeGrewEvent is emitted alongside each SnakeMovedEvent.
+func (r *ConstrictorRuleset) growingMoveSnakes(b *BoardState, moves []SnakeMove) ([]TurnEvent, error) {
+	for i := 0; i < len(b.Snakes); i++ {
+		if len(b.Snakes[i].Body) == 0 {
+			return nil, errors.New("found snake with zero size body")
+		}
+	}
+	if len(moves) < len(b.Snakes) {
+		return nil, errors.New("not enough snake moves")
+	}
+	if len(moves) > len(b.Snakes) {
+		return nil, errors.New("too many snake moves")
+	}
+
+	var events []TurnEvent
+	for _, move := range moves {
+		var snake *Snake
+		for i := 0; i < len(b.Snakes); i++ {
+			if b.Snakes[i].ID == move.ID {
+				snake = &b.Snakes[i]
+			}
+		}
+		if snake == nil {
+			return nil, errors.New("snake not found for move")
+		}
+		if snake.EliminatedCause != NotEliminated {
+			continue
+		}
+
+		newHead := r.nextHead(snake, move.Move)
+
+		// Append new head, keep the entire existing body: nothing is
+		// popped, so the snake grows by one every turn.
+		snake.Body = append([]Point{newHead}, snake.Body...)
+		events = append(events,
+			SnakeMovedEvent{SnakeID: snake.ID, Head: newHead},
+			SnakeGrewEvent{SnakeID: snake.ID, Amount: 1},
+		)
+	}
+	return events, nil
+}
+
+// nextHead computes the point a snake's head moves to for the given move,
+// defaulting to its last direction of travel (or up) when move is invalid.
+func (r *ConstrictorRuleset) nextHead(snake *Snake, move Move) Point {
+	head := snake.Body[0]
+	switch move {
+	case MoveDown:
+		return Point{X: head.X, Y: head.Y + 1}
+	case MoveLeft:
+		return Point{X: head.X - 1, Y: head.Y}
+	case MoveRight:
+		return Point{X: head.X + 1, Y: head.Y}
+	case MoveUp:
+		return Point{X: head.X, Y: head.Y - 1}
+	default:
+		var dX, dY int32 = 0, -1
+		if len(snake.Body) >= 2 {
+			dX = snake.Body[0].X - snake.Body[1].X
+			dY = snake.Body[0].Y - snake.Body[1].Y
+			if dX == 0 && dY == 0 {
+				dY = -1
+			}
+		}
+		return Point{X: head.X + dX, Y: head.Y + dY}
+	}
+}
@@ -0,0 +1,37 @@
+package rules
+
+import "testing"
+
+func TestConstrictorRulesetGrowsEveryTurnWithoutFood(t *testing.T) {
+	r := NewConstrictorRuleset()
+	board := &BoardState{
+		Width:  7,
+		Height: 7,
+		Snakes: []Snake{{ID: "s", Health: 42, Body: []Point{{3, 3}, {3, 3}, {3, 3}}}},
+	}
+
+	next, events, err := r.CreateNextBoardState(board, []SnakeMove{{ID: "s", Move: MoveUp}})
+	if err != nil {
+		t.Fatalf("CreateNextBoardState: %v", err)
+	}
+
+	if got, want := len(next.Snakes[0].Body), len(board.Snakes[0].Body)+1; got != want {
+		t.Errorf("body length = %d, want %d (grows by one every turn)", got, want)
+	}
+	if next.Snakes[0].Health != SnakeMaxHealth {
+		t.Errorf("Health = %d, want %d (reset every turn)", next.Snakes[0].Health, SnakeMaxHealth)
+	}
+	if len(next.Food) != 0 {
+		t.Errorf("Food = %v, want none: Constrictor never spawns food", next.Food)
+	}
+
+	var sawGrow bool
+	for _, e := range events {
+		if g, ok := e.(SnakeGrewEvent); ok && g.SnakeID == "s" && g.Amount == 1 {
+			sawGrow = true
+		}
+	}
+	if !sawGrow {
+		t.Error("expected a SnakeGrewEvent{Amount: 1}")
+	}
+}
@@ -0,0 +1,77 @@
+package rules
+
+// TurnEvent describes a single notable thing that happened while resolving
+// one turn via Ruleset.CreateNextBoardState. Callers that need to know
+// what changed between two BoardStates — replay tooling, renderers,
+// analytics — can consume the events rather than diffing the states
+// themselves.
+//
+// TurnEvent is a closed set of concrete event types; switch on the
+// concrete type to handle each one:
+//
+//	switch e := event.(type) {
+//	case SnakeMovedEvent:
+//	case SnakeAteEvent:
+//	case SnakeGrewEvent:
+//	case FoodSpawnedEvent:
+//	case SnakeEliminatedEvent:
+//	case HeadToHeadEvent:
+//	}
+type TurnEvent interface {
+	isTurnEvent()
+}
+
+// SnakeMovedEvent records that a snake's head moved to a new point.
+type SnakeMovedEvent struct {
+	SnakeID string
+	Head    Point
+}
+
+func (SnakeMovedEvent) isTurnEvent() {}
+
+// SnakeAteEvent records that a snake ate a piece of food.
+type SnakeAteEvent struct {
+	SnakeID string
+	Food    Point
+	Variant FoodVariantID
+}
+
+func (SnakeAteEvent) isTurnEvent() {}
+
+// SnakeGrewEvent records that a snake's body changed length, as a result of
+// eating or, under ConstrictorRuleset, of moving. Positive Amount is growth;
+// negative is shrinkage (e.g. a "poison" FoodVariant).
+type SnakeGrewEvent struct {
+	SnakeID string
+	Amount  int32
+}
+
+func (SnakeGrewEvent) isTurnEvent() {}
+
+// FoodSpawnedEvent records that a new piece of food appeared on the board.
+type FoodSpawnedEvent struct {
+	Food    Point
+	Variant FoodVariantID
+}
+
+func (FoodSpawnedEvent) isTurnEvent() {}
+
+// SnakeEliminatedEvent records that a snake was eliminated, and why.
+type SnakeEliminatedEvent struct {
+	SnakeID string
+	Cause   string
+	By      string
+}
+
+func (SnakeEliminatedEvent) isTurnEvent() {}
+
+// HeadToHeadEvent records that two snakes' heads landed on the same
+// square. It is emitted regardless of which snake (if either) is
+// eliminated by the collision; see the accompanying SnakeEliminatedEvent
+// for the outcome.
+type HeadToHeadEvent struct {
+	SnakeID string
+	OtherID string
+}
+
+func (HeadToHeadEvent) isTurnEvent() {}
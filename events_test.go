@@ -0,0 +1,86 @@
+package rules
+
+import "testing"
+
+func TestCreateNextBoardStateEmitsMoveAndEatEvents(t *testing.T) {
+	r := &StandardRuleset{}
+	board := &BoardState{
+		Width:  7,
+		Height: 7,
+		Food:   []Food{{Point: Point{3, 2}, Variant: DefaultFoodVariant}},
+		Snakes: []Snake{{ID: "s", Health: SnakeMaxHealth, Body: []Point{{3, 3}, {3, 4}, {3, 5}}}},
+	}
+
+	_, events, err := r.CreateNextBoardState(board, []SnakeMove{{ID: "s", Move: MoveUp}})
+	if err != nil {
+		t.Fatalf("CreateNextBoardState: %v", err)
+	}
+
+	var sawMove, sawAte, sawGrew bool
+	for _, e := range events {
+		switch ev := e.(type) {
+		case SnakeMovedEvent:
+			if ev.SnakeID == "s" && ev.Head == (Point{3, 2}) {
+				sawMove = true
+			}
+		case SnakeAteEvent:
+			if ev.SnakeID == "s" && ev.Food == (Point{3, 2}) {
+				sawAte = true
+			}
+		case SnakeGrewEvent:
+			if ev.SnakeID == "s" && ev.Amount == 1 {
+				sawGrew = true
+			}
+		}
+	}
+	if !sawMove {
+		t.Error("expected a SnakeMovedEvent for the snake's new head")
+	}
+	if !sawAte {
+		t.Error("expected a SnakeAteEvent for the food the snake moved onto")
+	}
+	if !sawGrew {
+		t.Error("expected a SnakeGrewEvent alongside the SnakeAteEvent")
+	}
+}
+
+func TestCreateNextBoardStateEmitsEliminationAndHeadToHeadEvents(t *testing.T) {
+	r := &StandardRuleset{}
+	board := &BoardState{
+		Width:  7,
+		Height: 7,
+		Snakes: []Snake{
+			{ID: "short", Health: SnakeMaxHealth, Body: []Point{{2, 3}, {2, 4}}},
+			{ID: "long", Health: SnakeMaxHealth, Body: []Point{{4, 3}, {4, 4}, {4, 5}}},
+		},
+	}
+	moves := []SnakeMove{
+		{ID: "short", Move: MoveRight},
+		{ID: "long", Move: MoveLeft},
+	}
+
+	_, events, err := r.CreateNextBoardState(board, moves)
+	if err != nil {
+		t.Fatalf("CreateNextBoardState: %v", err)
+	}
+
+	var sawHeadToHead, sawEliminated bool
+	for _, e := range events {
+		switch ev := e.(type) {
+		case HeadToHeadEvent:
+			if ev.SnakeID == "short" && ev.OtherID == "long" {
+				sawHeadToHead = true
+			}
+		case SnakeEliminatedEvent:
+			if ev.SnakeID == "short" && ev.Cause == EliminatedByHeadToHeadCollision && ev.By == "long" {
+				sawEliminated = true
+			}
+		}
+	}
+	if !sawHeadToHead {
+		t.Error("expected a HeadToHeadEvent between the two colliding snakes")
+	}
+	if !sawEliminated {
+		t.Error("expected the shorter snake to be eliminated by the head-to-head collision")
+	}
+}
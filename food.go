@@ -0,0 +1,96 @@
+package rules
+
+import "errors"
+
+// FoodVariantID identifies a registered FoodVariant.
+type FoodVariantID string
+
+// DefaultFoodVariant is used wherever a ruleset has no FoodVariants
+// registered, or a placement doesn't care about variety (e.g. the starting
+// food placed by CreateInitialBoardState on a standard board size). It reproduces classic food
+// behavior: grow by one, heal to full, worth one point.
+const DefaultFoodVariant = FoodVariantID("standard")
+
+// Food is a single piece of food on the board, tagged with which
+// FoodVariant it is so that feeding a snake looks up the right effect.
+type Food struct {
+	Point
+	Variant FoodVariantID
+}
+
+// FoodVariant describes how a kind of food affects the snake that eats it:
+// how likely it is to spawn relative to other variants, how much it grows
+// and heals the snake, and how many points it's worth.
+type FoodVariant struct {
+	ID FoodVariantID
+
+	// SpawnWeight controls how often this variant is chosen relative to
+	// the other registered variants when new food spawns.
+	SpawnWeight int
+
+	// Growth is how many body segments the snake gains when it eats this
+	// variant. Negative values (a "poison" variant) shrink the snake
+	// instead, down to a minimum length of 1.
+	Growth int32
+
+	// HealHealth is the health the snake is set to after eating this
+	// variant.
+	HealHealth int32
+
+	// Points is added to the snake's Score when it eats this variant.
+	Points int32
+}
+
+func defaultFoodVariant() FoodVariant {
+	return FoodVariant{
+		ID:          DefaultFoodVariant,
+		SpawnWeight: 1,
+		Growth:      1,
+		HealHealth:  SnakeMaxHealth,
+		Points:      1,
+	}
+}
+
+// foodVariants returns the ruleset's configured FoodVariants, falling back
+// to a single default variant that reproduces classic food behavior.
+func (r *StandardRuleset) foodVariants() []FoodVariant {
+	if len(r.FoodVariants) == 0 {
+		return []FoodVariant{defaultFoodVariant()}
+	}
+	return r.FoodVariants
+}
+
+// foodVariant looks up a registered FoodVariant by ID, falling back to the
+// default variant's effect if the ID isn't registered.
+func (r *StandardRuleset) foodVariant(id FoodVariantID) FoodVariant {
+	for _, variant := range r.foodVariants() {
+		if variant.ID == id {
+			return variant
+		}
+	}
+	return defaultFoodVariant()
+}
+
+// pickFoodVariant chooses a registered FoodVariant at random, weighted by
+// SpawnWeight.
+func (r *StandardRuleset) pickFoodVariant() (FoodVariant, error) {
+	variants := r.foodVariants()
+
+	totalWeight := 0
+	for _, variant := range variants {
+		totalWeight += variant.SpawnWeight
+	}
+	if totalWeight <= 0 {
+		return FoodVariant{}, errors.New("no food variants with positive spawn weight")
+	}
+
+	roll := r.randIntn(totalWeight)
+	for _, variant := range variants {
+		if roll < variant.SpawnWeight {
+			return variant, nil
+		}
+		roll -= variant.SpawnWeight
+	}
+	// Unreachable given totalWeight > 0, but keeps the function total.
+	return variants[len(variants)-1], nil
+}
@@ -0,0 +1,59 @@
+package rules
+
+import "testing"
+
+func TestPickFoodVariantRespectsSpawnWeight(t *testing.T) {
+	r := WithSeed(1)
+	r.FoodVariants = []FoodVariant{
+		{ID: "always", SpawnWeight: 1},
+		{ID: "never", SpawnWeight: 0},
+	}
+
+	for i := 0; i < 50; i++ {
+		variant, err := r.pickFoodVariant()
+		if err != nil {
+			t.Fatalf("pickFoodVariant: %v", err)
+		}
+		if variant.ID != "always" {
+			t.Fatalf("picked variant %q, want only \"always\" ever picked (SpawnWeight 0 should never be chosen)", variant.ID)
+		}
+	}
+}
+
+func TestPickFoodVariantErrorsWithNoPositiveWeight(t *testing.T) {
+	r := WithSeed(1)
+	r.FoodVariants = []FoodVariant{{ID: "never", SpawnWeight: 0}}
+
+	if _, err := r.pickFoodVariant(); err == nil {
+		t.Error("expected an error when no FoodVariant has a positive SpawnWeight")
+	}
+}
+
+func TestFeedSnakeAppliesVariantEffects(t *testing.T) {
+	r := &StandardRuleset{}
+	snake := &Snake{Health: 10, Score: 5, Body: []Point{{0, 0}, {0, 0}}}
+	variant := FoodVariant{ID: "golden", Growth: 3, HealHealth: 80, Points: 10}
+
+	r.feedSnake(snake, variant)
+
+	if len(snake.Body) != 5 {
+		t.Errorf("body length = %d, want 5 (2 + Growth 3)", len(snake.Body))
+	}
+	if snake.Health != 80 {
+		t.Errorf("Health = %d, want 80 (set to HealHealth)", snake.Health)
+	}
+	if snake.Score != 15 {
+		t.Errorf("Score = %d, want 15 (5 + Points 10)", snake.Score)
+	}
+}
+
+func TestGrowSnakeShrinksForPoisonButKeepsAtLeastOneSegment(t *testing.T) {
+	r := &StandardRuleset{}
+	snake := &Snake{Body: []Point{{0, 0}, {0, 1}, {0, 2}}}
+
+	r.growSnake(snake, -5)
+
+	if len(snake.Body) != 1 {
+		t.Errorf("body length = %d, want 1 (shrinking never removes the last segment)", len(snake.Body))
+	}
+}
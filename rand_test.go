@@ -0,0 +1,90 @@
+package rules
+
+import "testing"
+
+func TestWithSeedIsDeterministic(t *testing.T) {
+	r1 := WithSeed(42)
+	r2 := WithSeed(42)
+
+	b1, err := r1.CreateInitialBoardState(11, 11, []string{"a", "b", "c", "d"})
+	if err != nil {
+		t.Fatalf("CreateInitialBoardState (r1): %v", err)
+	}
+	b2, err := r2.CreateInitialBoardState(11, 11, []string{"a", "b", "c", "d"})
+	if err != nil {
+		t.Fatalf("CreateInitialBoardState (r2): %v", err)
+	}
+	assertBoardStatesEqual(t, b1, b2)
+
+	moves := make([]SnakeMove, len(b1.Snakes))
+	for i, snake := range b1.Snakes {
+		moves[i] = SnakeMove{ID: snake.ID, Move: MoveUp}
+	}
+	for turn := 0; turn < 10; turn++ {
+		var err error
+		b1, _, err = r1.CreateNextBoardState(b1, moves)
+		if err != nil {
+			t.Fatalf("CreateNextBoardState (r1) turn %d: %v", turn, err)
+		}
+		b2, _, err = r2.CreateNextBoardState(b2, moves)
+		if err != nil {
+			t.Fatalf("CreateNextBoardState (r2) turn %d: %v", turn, err)
+		}
+		assertBoardStatesEqual(t, b1, b2)
+	}
+}
+
+func TestWithSeedDiffersAcrossSeeds(t *testing.T) {
+	b1, err := WithSeed(1).CreateInitialBoardState(11, 11, []string{"a", "b", "c", "d"})
+	if err != nil {
+		t.Fatalf("CreateInitialBoardState (seed 1): %v", err)
+	}
+	b2, err := WithSeed(2).CreateInitialBoardState(11, 11, []string{"a", "b", "c", "d"})
+	if err != nil {
+		t.Fatalf("CreateInitialBoardState (seed 2): %v", err)
+	}
+
+	same := true
+	for i := range b1.Snakes {
+		if b1.Snakes[i].Body[0] != b2.Snakes[i].Body[0] {
+			same = false
+		}
+	}
+	if same {
+		t.Error("expected different seeds to (almost certainly) produce different placements")
+	}
+}
+
+// assertBoardStatesEqual fails t if a and b differ in anything WithSeed is
+// supposed to make reproducible: dimensions, turn, food, and every snake's
+// identity, health, and body.
+func assertBoardStatesEqual(t *testing.T, a, b *BoardState) {
+	t.Helper()
+	if a.Width != b.Width || a.Height != b.Height || a.Turn != b.Turn {
+		t.Fatalf("dimensions/turn differ: %+v vs %+v", a, b)
+	}
+	if len(a.Food) != len(b.Food) {
+		t.Fatalf("Food differs: %v vs %v", a.Food, b.Food)
+	}
+	for i := range a.Food {
+		if a.Food[i] != b.Food[i] {
+			t.Fatalf("Food[%d] differs: %v vs %v", i, a.Food[i], b.Food[i])
+		}
+	}
+	if len(a.Snakes) != len(b.Snakes) {
+		t.Fatalf("snake count differs: %d vs %d", len(a.Snakes), len(b.Snakes))
+	}
+	for i := range a.Snakes {
+		if a.Snakes[i].ID != b.Snakes[i].ID || a.Snakes[i].Health != b.Snakes[i].Health {
+			t.Fatalf("Snakes[%d] differ: %+v vs %+v", i, a.Snakes[i], b.Snakes[i])
+		}
+		if len(a.Snakes[i].Body) != len(b.Snakes[i].Body) {
+			t.Fatalf("Snakes[%d].Body length differs: %v vs %v", i, a.Snakes[i].Body, b.Snakes[i].Body)
+		}
+		for j := range a.Snakes[i].Body {
+			if a.Snakes[i].Body[j] != b.Snakes[i].Body[j] {
+				t.Fatalf("Snakes[%d].Body[%d] differs: %v vs %v", i, j, a.Snakes[i].Body[j], b.Snakes[i].Body[j])
+			}
+		}
+	}
+}
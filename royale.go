@@ -0,0 +1,139 @@
+package rules
+
+const (
+	// DefaultRoyaleShrinkEveryNTurns is how often, in turns, the hazard
+	// zone grows by one more ring from each edge of the board.
+	DefaultRoyaleShrinkEveryNTurns = 25
+	// DefaultRoyaleDamagePerTurn is the extra health lost by a snake whose
+	// head is in a hazard square, on top of normal per-turn health loss.
+	DefaultRoyaleDamagePerTurn = 14
+)
+
+// RoyaleRulesetOptions configures the hazard shrink schedule used by
+// RoyaleRuleset.
+type RoyaleRulesetOptions struct {
+	// ShrinkEveryNTurns controls how often the hazard zone grows inward by
+	// one ring. Zero means "use the default".
+	ShrinkEveryNTurns int32
+	// DamagePerTurn is the health lost by a snake for each turn its head
+	// spends in a hazard square. Zero means "use the default".
+	DamagePerTurn int32
+}
+
+// RoyaleRuleset is the Battlesnake Royale variant: over time the play area
+// shrinks into a growing hazard zone that damages any snake caught inside
+// it, forcing snakes toward the center as the game goes on.
+type RoyaleRuleset struct {
+	StandardRuleset
+	Options RoyaleRulesetOptions
+}
+
+// NewRoyaleRuleset constructs a RoyaleRuleset, filling in default shrink
+// timing and damage for any zero-valued options.
+func NewRoyaleRuleset(options RoyaleRulesetOptions) *RoyaleRuleset {
+	if options.ShrinkEveryNTurns <= 0 {
+		options.ShrinkEveryNTurns = DefaultRoyaleShrinkEveryNTurns
+	}
+	if options.DamagePerTurn <= 0 {
+		options.DamagePerTurn = DefaultRoyaleDamagePerTurn
+	}
+	return &RoyaleRuleset{Options: options}
+}
+
+// Name implements Ruleset.
+func (r *RoyaleRuleset) Name() string { return "royale" }
+
+func (r *RoyaleRuleset) CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error) {
+	nextState, events, err := r.StandardRuleset.CreateNextBoardState(prevState, moves)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.populateHazards(nextState); err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.damageSnakesInHazards(nextState); err != nil {
+		return nil, nil, err
+	}
+
+	events = append(events, r.eliminateStarvedSnakes(nextState)...)
+
+	return nextState, events, nil
+}
+
+// shrinkEveryNTurns returns Options.ShrinkEveryNTurns, falling back to
+// DefaultRoyaleShrinkEveryNTurns for a zero-valued RoyaleRuleset (e.g.
+// &RoyaleRuleset{} built directly instead of via NewRoyaleRuleset).
+func (r *RoyaleRuleset) shrinkEveryNTurns() int32 {
+	if r.Options.ShrinkEveryNTurns <= 0 {
+		return DefaultRoyaleShrinkEveryNTurns
+	}
+	return r.Options.ShrinkEveryNTurns
+}
+
+// damagePerTurn returns Options.DamagePerTurn, falling back to
+// DefaultRoyaleDamagePerTurn for a zero-valued RoyaleRuleset.
+func (r *RoyaleRuleset) damagePerTurn() int32 {
+	if r.Options.DamagePerTurn <= 0 {
+		return DefaultRoyaleDamagePerTurn
+	}
+	return r.Options.DamagePerTurn
+}
+
+// populateHazards grows the hazard zone by one ring from each edge of the
+// board every ShrinkEveryNTurns turns, up to the center of the board.
+func (r *RoyaleRuleset) populateHazards(b *BoardState) error {
+	maxRings := b.Width
+	if b.Height < maxRings {
+		maxRings = b.Height
+	}
+	maxRings = maxRings / 2
+
+	rings := b.Turn / r.shrinkEveryNTurns()
+	if rings > maxRings {
+		rings = maxRings
+	}
+
+	hazards := []Point{}
+	for ring := int32(0); ring < rings; ring++ {
+		for x := ring; x < b.Width-ring; x++ {
+			hazards = append(hazards, Point{X: x, Y: ring}, Point{X: x, Y: b.Height - 1 - ring})
+		}
+		for y := ring + 1; y < b.Height-1-ring; y++ {
+			hazards = append(hazards, Point{X: ring, Y: y}, Point{X: b.Width - 1 - ring, Y: y})
+		}
+	}
+	b.Hazards = hazards
+	return nil
+}
+
+// damageSnakesInHazards applies extra per-turn damage to any living snake
+// whose head is currently within a hazard square.
+func (r *RoyaleRuleset) damageSnakesInHazards(b *BoardState) error {
+	for i := 0; i < len(b.Snakes); i++ {
+		snake := &b.Snakes[i]
+		if snake.EliminatedCause != NotEliminated {
+			continue
+		}
+		if containsPoint(b.Hazards, snake.Body[0]) {
+			snake.Health -= r.damagePerTurn()
+		}
+	}
+	return nil
+}
+
+// eliminateStarvedSnakes re-checks for starvation after hazard damage has
+// been applied, since hazard damage can bring a snake's health below zero
+// after StandardRuleset has already run its own elimination pass.
+func (r *RoyaleRuleset) eliminateStarvedSnakes(b *BoardState) []TurnEvent {
+	var events []TurnEvent
+	for i := 0; i < len(b.Snakes); i++ {
+		snake := &b.Snakes[i]
+		if snake.EliminatedCause == NotEliminated && r.snakeHasStarved(snake) {
+			snake.EliminatedCause = EliminatedByStarvation
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause})
+		}
+	}
+	return events
+}
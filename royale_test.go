@@ -0,0 +1,42 @@
+package rules
+
+import "testing"
+
+// TestRoyaleRulesetZeroValue is a regression test: a &RoyaleRuleset{} built
+// directly (skipping NewRoyaleRuleset) used to panic dividing by a
+// zero-valued Options.ShrinkEveryNTurns.
+func TestRoyaleRulesetZeroValue(t *testing.T) {
+	r := &RoyaleRuleset{}
+	board := &BoardState{
+		Width:  3,
+		Height: 3,
+		Snakes: []Snake{{ID: "s", Health: SnakeMaxHealth, Body: []Point{{1, 1}}}},
+	}
+	if _, _, err := r.CreateNextBoardState(board, []SnakeMove{{ID: "s", Move: MoveUp}}); err != nil {
+		t.Fatalf("CreateNextBoardState on zero-value RoyaleRuleset: %v", err)
+	}
+}
+
+func TestRoyaleRulesetDamagesSnakesInHazards(t *testing.T) {
+	r := NewRoyaleRuleset(RoyaleRulesetOptions{ShrinkEveryNTurns: 1, DamagePerTurn: 5})
+	board := &BoardState{
+		Width:  3,
+		Height: 3,
+		Snakes: []Snake{{ID: "s", Health: SnakeMaxHealth, Body: []Point{{1, 1}}}},
+	}
+
+	next, _, err := r.CreateNextBoardState(board, []SnakeMove{{ID: "s", Move: MoveLeft}})
+	if err != nil {
+		t.Fatalf("CreateNextBoardState: %v", err)
+	}
+
+	head := next.Snakes[0].Body[0]
+	if !containsPoint(next.Hazards, head) {
+		t.Fatalf("expected the outer ring to already cover the snake's new head %v on turn 1, hazards: %v", head, next.Hazards)
+	}
+
+	wantHealth := SnakeMaxHealth - 1 - 5 // -1 per-turn, -5 hazard damage
+	if next.Snakes[0].Health != int32(wantHealth) {
+		t.Errorf("Health = %d, want %d", next.Snakes[0].Health, wantHealth)
+	}
+}
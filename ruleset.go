@@ -0,0 +1,25 @@
+package rules
+
+// Ruleset determines what happens to a board from turn to turn. Each
+// variant of the game (Standard, Royale, Squad, Constrictor, Wrapped, ...)
+// implements Ruleset to describe how boards are initialized, how a turn is
+// resolved, and when the game is over.
+type Ruleset interface {
+	// Name returns a short, stable identifier for the ruleset (e.g.
+	// "standard", "royale").
+	Name() string
+
+	// CreateInitialBoardState creates a new BoardState for the given
+	// dimensions and snake IDs, placing snakes and food according to the
+	// ruleset's rules.
+	CreateInitialBoardState(width int32, height int32, snakeIDs []string) (*BoardState, error)
+
+	// CreateNextBoardState advances prevState by one turn, applying moves
+	// and returning the resulting BoardState alongside the TurnEvents that
+	// occurred while resolving it. prevState is not modified.
+	CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error)
+
+	// IsGameOver reports whether the game has ended given the current
+	// BoardState.
+	IsGameOver(b *BoardState) (bool, error)
+}
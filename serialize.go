@@ -0,0 +1,379 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// MarshalText and UnmarshalText encode a BoardState as a compact ASCII
+// grid, meant for hand-writing small fixtures in table-driven tests (e.g.
+// "snake eats last-turn food and survives") instead of constructing snake
+// bodies point by point.
+//
+// The format is three lines:
+//
+//	<width>x<height>
+//	snakes: <id1>,<id2>,...
+//	<row> / <row> / ...
+//
+// Rows run from the top of the board (highest Y) to the bottom, and cells
+// within a row are space-separated, left to right (lowest X first). Each
+// cell is one of:
+//
+//	.   empty
+//	f   food
+//	~   hazard
+//	A-Z a snake's head, by its position (0-indexed) in the snakes line
+//	a-z that snake's body
+//
+// The text format only recovers snake ID, body, and board layout — not
+// health, turn, or score, which the JSON codec (MarshalJSON/UnmarshalJSON)
+// preserves in full. UnmarshalText reconstructs each snake's body by
+// walking from its head through adjacent cells, so it round-trips any body
+// whose path never touches itself; a body that does (or that isn't a
+// single connected path) can't be reconstructed unambiguously and
+// UnmarshalText returns an error instead of guessing.
+//
+// The one-glyph-per-cell grid also can't represent more than one body
+// segment stacked on the same point — which is the common case, not a rare
+// one: a freshly-placed snake's starting segments all sit on one point
+// (placeSnakesFixed/placeSnakesRandomly), and growSnake duplicates the tail
+// point for every segment of growth. MarshalText detects this and returns
+// an error rather than silently collapsing the stacked segments into one
+// cell and truncating the snake.
+func (b *BoardState) MarshalText() ([]byte, error) {
+	if len(b.Snakes) > 26 {
+		return nil, errors.New("MarshalText supports at most 26 snakes")
+	}
+
+	cell := make(map[Point]rune, int(b.Width)*int(b.Height))
+	for _, hazard := range b.Hazards {
+		cell[hazard] = '~'
+	}
+	for _, food := range b.Food {
+		cell[food.Point] = 'f'
+	}
+	for i, snake := range b.Snakes {
+		letter := rune('A' + i)
+		seen := make(map[Point]bool, len(snake.Body))
+		for _, p := range snake.Body {
+			if seen[p] {
+				return nil, fmt.Errorf("snake %q has more than one body segment stacked at %v, which the text grid's one-glyph-per-cell format can't represent", snake.ID, p)
+			}
+			seen[p] = true
+			cell[p] = letter + ('a' - 'A')
+		}
+		if len(snake.Body) > 0 {
+			cell[snake.Body[0]] = letter
+		}
+	}
+
+	ids := make([]string, len(b.Snakes))
+	for i, snake := range b.Snakes {
+		ids[i] = snake.ID
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "%dx%d\n", b.Width, b.Height)
+	fmt.Fprintf(&out, "snakes: %s\n", strings.Join(ids, ","))
+
+	rows := make([]string, 0, b.Height)
+	for y := b.Height - 1; y >= 0; y-- {
+		row := make([]string, b.Width)
+		for x := int32(0); x < b.Width; x++ {
+			if r, ok := cell[Point{X: x, Y: y}]; ok {
+				row[x] = string(r)
+			} else {
+				row[x] = "."
+			}
+		}
+		rows = append(rows, strings.Join(row, " "))
+	}
+	out.WriteString(strings.Join(rows, " / "))
+
+	return []byte(out.String()), nil
+}
+
+func (b *BoardState) UnmarshalText(text []byte) error {
+	lines := strings.SplitN(strings.TrimRight(string(text), "\n"), "\n", 3)
+	if len(lines) < 3 {
+		return errors.New("invalid board text: expected dimensions, snake legend, and grid lines")
+	}
+
+	var width, height int32
+	if _, err := fmt.Sscanf(lines[0], "%dx%d", &width, &height); err != nil {
+		return fmt.Errorf("invalid board dimensions %q: %w", lines[0], err)
+	}
+
+	snakesLine := strings.TrimPrefix(lines[1], "snakes: ")
+	var ids []string
+	if snakesLine != "" {
+		ids = strings.Split(snakesLine, ",")
+	}
+	if len(ids) > 26 {
+		return errors.New("UnmarshalText supports at most 26 snakes")
+	}
+
+	rows := strings.Split(lines[2], " / ")
+	if int32(len(rows)) != height {
+		return fmt.Errorf("expected %d rows, got %d", height, len(rows))
+	}
+
+	heads := make([]Point, len(ids))
+	hasHead := make([]bool, len(ids))
+	bodies := make([][]Point, len(ids))
+	var food []Food
+	var hazards []Point
+
+	for displayRow, row := range rows {
+		y := height - 1 - int32(displayRow)
+		cells := strings.Split(row, " ")
+		if int32(len(cells)) != width {
+			return fmt.Errorf("row %d: expected %d cells, got %d", displayRow, width, len(cells))
+		}
+		for x, cellStr := range cells {
+			if cellStr == "." {
+				continue
+			}
+			p := Point{X: int32(x), Y: y}
+			switch cellStr {
+			case "f":
+				food = append(food, Food{Point: p, Variant: DefaultFoodVariant})
+				continue
+			case "~":
+				hazards = append(hazards, p)
+				continue
+			}
+
+			letter := cellStr[0]
+			var idx int
+			var isHead bool
+			switch {
+			case letter >= 'A' && letter <= 'Z':
+				idx, isHead = int(letter-'A'), true
+			case letter >= 'a' && letter <= 'z':
+				idx, isHead = int(letter-'a'), false
+			default:
+				return fmt.Errorf("unrecognized cell %q at row %d col %d", cellStr, displayRow, x)
+			}
+			if idx >= len(ids) {
+				return fmt.Errorf("cell %q at row %d col %d references unknown snake", cellStr, displayRow, x)
+			}
+			if isHead {
+				heads[idx], hasHead[idx] = p, true
+			} else {
+				bodies[idx] = append(bodies[idx], p)
+			}
+		}
+	}
+
+	b.Width = width
+	b.Height = height
+	b.Food = food
+	b.Hazards = hazards
+	b.Snakes = make([]Snake, len(ids))
+	for i, id := range ids {
+		body, err := orderSnakeBody(id, heads[i], hasHead[i], bodies[i])
+		if err != nil {
+			return err
+		}
+		b.Snakes[i] = Snake{ID: id, Health: SnakeMaxHealth, Body: body}
+	}
+	return nil
+}
+
+// orderSnakeBody reconstructs a snake's body, head to tail, by walking from
+// head through its unordered body cells, at each step stepping to whichever
+// remaining cell is orthogonally adjacent to the current one. A cell's
+// position in the grid says nothing about its place along the snake, so a
+// body can only be reconstructed when that walk is unambiguous: if a cell
+// has more than one unvisited neighbor (the snake's path touches itself) or
+// some cell is never reached (it isn't part of a single connected path),
+// this returns an error rather than guessing and silently producing a
+// corrupt, non-contiguous body.
+func orderSnakeBody(id string, head Point, hasHead bool, rest []Point) ([]Point, error) {
+	if !hasHead {
+		if len(rest) > 0 {
+			return nil, fmt.Errorf("snake %q: has body cells but no head", id)
+		}
+		return nil, nil
+	}
+
+	remaining := make(map[Point]bool, len(rest))
+	for _, p := range rest {
+		remaining[p] = true
+	}
+
+	body := make([]Point, 1, len(rest)+1)
+	body[0] = head
+	current := head
+	for len(remaining) > 0 {
+		neighbors := []Point{
+			{X: current.X, Y: current.Y - 1},
+			{X: current.X, Y: current.Y + 1},
+			{X: current.X - 1, Y: current.Y},
+			{X: current.X + 1, Y: current.Y},
+		}
+		var next *Point
+		for i, n := range neighbors {
+			if !remaining[n] {
+				continue
+			}
+			if next != nil {
+				return nil, fmt.Errorf("snake %q: body cell %v has more than one unvisited neighbor, can't reconstruct its path unambiguously", id, current)
+			}
+			next = &neighbors[i]
+		}
+		if next == nil {
+			return nil, fmt.Errorf("snake %q: body has a cell that isn't connected to the head by a path", id)
+		}
+		body = append(body, *next)
+		delete(remaining, *next)
+		current = *next
+	}
+	return body, nil
+}
+
+// The JSON codec below mirrors the board object in the Battlesnake game
+// engine wire format (height/width/food/hazards/snakes, points as
+// {"x":_,"y":_}), plus this package's own extensions (turn, squad, score,
+// food variant, elimination) carried as additional fields.
+
+type jsonPoint struct {
+	X int32 `json:"x"`
+	Y int32 `json:"y"`
+}
+
+type jsonFood struct {
+	X       int32         `json:"x"`
+	Y       int32         `json:"y"`
+	Variant FoodVariantID `json:"variant,omitempty"`
+}
+
+type jsonSnake struct {
+	ID              string      `json:"id"`
+	Health          int32       `json:"health"`
+	Body            []jsonPoint `json:"body"`
+	Head            jsonPoint   `json:"head"`
+	Length          int32       `json:"length"`
+	Squad           string      `json:"squad,omitempty"`
+	Score           int32       `json:"score,omitempty"`
+	EliminatedCause string      `json:"eliminatedCause,omitempty"`
+	EliminatedBy    string      `json:"eliminatedBy,omitempty"`
+}
+
+type jsonBoardState struct {
+	Turn    int32       `json:"turn"`
+	Height  int32       `json:"height"`
+	Width   int32       `json:"width"`
+	Food    []jsonFood  `json:"food"`
+	Hazards []jsonPoint `json:"hazards"`
+	Snakes  []jsonSnake `json:"snakes"`
+}
+
+func (b *BoardState) MarshalJSON() ([]byte, error) {
+	out := jsonBoardState{
+		Turn:   b.Turn,
+		Height: b.Height,
+		Width:  b.Width,
+	}
+	for _, food := range b.Food {
+		out.Food = append(out.Food, jsonFood{X: food.X, Y: food.Y, Variant: food.Variant})
+	}
+	for _, hazard := range b.Hazards {
+		out.Hazards = append(out.Hazards, jsonPoint{X: hazard.X, Y: hazard.Y})
+	}
+	for _, snake := range b.Snakes {
+		js := jsonSnake{
+			ID:              snake.ID,
+			Health:          snake.Health,
+			Length:          int32(len(snake.Body)),
+			Squad:           snake.Squad,
+			Score:           snake.Score,
+			EliminatedCause: snake.EliminatedCause,
+			EliminatedBy:    snake.EliminatedBy,
+		}
+		for _, p := range snake.Body {
+			js.Body = append(js.Body, jsonPoint{X: p.X, Y: p.Y})
+		}
+		if len(snake.Body) > 0 {
+			js.Head = jsonPoint{X: snake.Body[0].X, Y: snake.Body[0].Y}
+		}
+		out.Snakes = append(out.Snakes, js)
+	}
+	return json.Marshal(out)
+}
+
+func (b *BoardState) UnmarshalJSON(data []byte) error {
+	var in jsonBoardState
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	b.Turn = in.Turn
+	b.Height = in.Height
+	b.Width = in.Width
+
+	b.Food = make([]Food, 0, len(in.Food))
+	for _, food := range in.Food {
+		variant := food.Variant
+		if variant == "" {
+			variant = DefaultFoodVariant
+		}
+		b.Food = append(b.Food, Food{Point: Point{X: food.X, Y: food.Y}, Variant: variant})
+	}
+
+	b.Hazards = make([]Point, 0, len(in.Hazards))
+	for _, hazard := range in.Hazards {
+		b.Hazards = append(b.Hazards, Point{X: hazard.X, Y: hazard.Y})
+	}
+
+	b.Snakes = make([]Snake, 0, len(in.Snakes))
+	for _, snake := range in.Snakes {
+		s := Snake{
+			ID:              snake.ID,
+			Health:          snake.Health,
+			Squad:           snake.Squad,
+			Score:           snake.Score,
+			EliminatedCause: snake.EliminatedCause,
+			EliminatedBy:    snake.EliminatedBy,
+		}
+		for _, p := range snake.Body {
+			s.Body = append(s.Body, Point{X: p.X, Y: p.Y})
+		}
+		b.Snakes = append(b.Snakes, s)
+	}
+
+	return nil
+}
+
+// LoadFixture reads a BoardState from the text fixture format described on
+// BoardState.MarshalText.
+func LoadFixture(path string) (*BoardState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b := &BoardState{}
+	if err := b.UnmarshalText(data); err != nil {
+		return nil, fmt.Errorf("parsing fixture %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// SaveReplay writes states to w as newline-delimited JSON (one BoardState
+// per line), suitable for streaming to a file and later replaying turn by
+// turn.
+func SaveReplay(w io.Writer, states []*BoardState) error {
+	enc := json.NewEncoder(w)
+	for _, state := range states {
+		if err := enc.Encode(state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBoardStateTextRoundTrip(t *testing.T) {
+	b := &BoardState{
+		Width:   3,
+		Height:  3,
+		Food:    []Food{{Point: Point{1, 1}, Variant: DefaultFoodVariant}},
+		Hazards: []Point{{0, 0}},
+		Snakes: []Snake{
+			{ID: "a", Body: []Point{{2, 0}, {2, 1}, {2, 2}}},
+		},
+	}
+
+	text, err := b.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+
+	var got BoardState
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(%q): %v", text, err)
+	}
+
+	if got.Width != b.Width || got.Height != b.Height {
+		t.Errorf("dimensions = %dx%d, want %dx%d", got.Width, got.Height, b.Width, b.Height)
+	}
+	if len(got.Food) != 1 || got.Food[0].Point != b.Food[0].Point {
+		t.Errorf("Food = %v, want %v", got.Food, b.Food)
+	}
+	if len(got.Hazards) != 1 || got.Hazards[0] != b.Hazards[0] {
+		t.Errorf("Hazards = %v, want %v", got.Hazards, b.Hazards)
+	}
+	if len(got.Snakes) != 1 || got.Snakes[0].ID != "a" {
+		t.Fatalf("Snakes = %v", got.Snakes)
+	}
+	if !bodiesEqual(got.Snakes[0].Body, b.Snakes[0].Body) {
+		t.Errorf("Body = %v, want %v (head-to-tail order preserved)", got.Snakes[0].Body, b.Snakes[0].Body)
+	}
+}
+
+func TestMarshalTextRejectsStackedBodySegments(t *testing.T) {
+	// A freshly-placed snake's starting segments all sit on one point, and
+	// growSnake duplicates the tail point for growth — both are the common
+	// case, not an edge case, and the one-glyph-per-cell grid can't
+	// represent them.
+	ruleset := WithSeed(1)
+	board, err := ruleset.CreateInitialBoardState(7, 7, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("CreateInitialBoardState: %v", err)
+	}
+
+	if _, err := board.MarshalText(); err == nil {
+		t.Error("expected MarshalText to error on a freshly-placed snake's stacked starting segments, got nil")
+	}
+}
+
+func TestUnmarshalTextRejectsAmbiguousBody(t *testing.T) {
+	// A 2x2 square body: every cell has two unvisited neighbors at the
+	// branch point, so the path back to the head can't be reconstructed.
+	text := "2x2\nsnakes: a\nA a / a a"
+	var b BoardState
+	if err := b.UnmarshalText([]byte(text)); err == nil {
+		t.Error("expected an error reconstructing a body whose path touches itself, got nil")
+	}
+}
+
+func bodiesEqual(a, b []Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBoardStateJSONRoundTrip(t *testing.T) {
+	b := &BoardState{
+		Turn:    7,
+		Width:   11,
+		Height:  11,
+		Food:    []Food{{Point: Point{1, 2}, Variant: "golden"}},
+		Hazards: []Point{{0, 0}},
+		Snakes: []Snake{
+			{ID: "a", Health: 45, Body: []Point{{1, 1}, {1, 2}}, Squad: "red", Score: 3},
+		},
+	}
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"height"`) || !strings.Contains(string(data), `"x"`) {
+		t.Errorf("expected wire-format field names in %s", data)
+	}
+
+	var got BoardState
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got.Turn != b.Turn || got.Width != b.Width || got.Height != b.Height {
+		t.Errorf("got %+v, want dimensions/turn from %+v", got, b)
+	}
+	if len(got.Snakes) != 1 || got.Snakes[0].Squad != "red" || got.Snakes[0].Score != 3 || got.Snakes[0].Health != 45 {
+		t.Errorf("Snakes = %+v", got.Snakes)
+	}
+	if len(got.Food) != 1 || got.Food[0].Variant != "golden" {
+		t.Errorf("Food = %+v", got.Food)
+	}
+}
+
+func TestSaveReplayWritesNDJSON(t *testing.T) {
+	states := []*BoardState{
+		{Turn: 0, Width: 3, Height: 3},
+		{Turn: 1, Width: 3, Height: 3},
+	}
+
+	var buf bytes.Buffer
+	if err := SaveReplay(&buf, states); err != nil {
+		t.Fatalf("SaveReplay: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != len(states) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(states))
+	}
+	for i, line := range lines {
+		var got BoardState
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+		if got.Turn != states[i].Turn {
+			t.Errorf("line %d: Turn = %d, want %d", i, got.Turn, states[i].Turn)
+		}
+	}
+}
+
+func TestLoadFixture(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "board.txt")
+	text := "3x3\nsnakes: a\n. . . / . A . / . . ."
+	if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	b, err := LoadFixture(path)
+	if err != nil {
+		t.Fatalf("LoadFixture: %v", err)
+	}
+	if len(b.Snakes) != 1 || b.Snakes[0].ID != "a" {
+		t.Fatalf("Snakes = %v", b.Snakes)
+	}
+	if got, want := b.Snakes[0].Body[0], (Point{1, 1}); got != want {
+		t.Errorf("head = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,122 @@
+package rules
+
+// This file exposes a lightweight simulation/rollout API for AI clients
+// that need to explore many hypothetical futures per turn (MCTS, minimax,
+// alpha-beta search, and the like). PossibleMoves, Simulate, and Rollout
+// are plain functions over the Ruleset interface rather than methods on
+// StandardRuleset, since variant rulesets (Royale, Squad, ...) override
+// CreateNextBoardState/IsGameOver and Go doesn't dispatch through struct
+// embedding — going through the interface keeps simulation correct for
+// every ruleset.
+//
+// A Ruleset built on StandardRuleset (all of the ones in this package) is
+// not safe to call Simulate/Rollout on from multiple goroutines at once —
+// give each worker its own ruleset instance.
+
+// BoardWrapper is implemented by rulesets, such as WrappedRuleset, whose
+// board has no edges: a snake that moves off one side reappears on the
+// opposite side instead of being eliminated. PossibleMoves consults it so
+// it doesn't prune moves that are only "out of bounds" on a ruleset that
+// doesn't treat the board's edges that way.
+type BoardWrapper interface {
+	WrapsBoard() bool
+}
+
+// PossibleMoves returns the moves available to the snake identified by
+// snakeID that aren't obviously suicidal under r: moving into its own neck,
+// or off the board for a ruleset that doesn't wrap it. It does not look
+// further ahead than one square, so it can still return moves that lead to
+// an eventual collision.
+func PossibleMoves(r Ruleset, b *BoardState, snakeID string) []SnakeMove {
+	var snake *Snake
+	for i := range b.Snakes {
+		if b.Snakes[i].ID == snakeID {
+			snake = &b.Snakes[i]
+			break
+		}
+	}
+	if snake == nil || len(snake.Body) == 0 {
+		return nil
+	}
+
+	head := snake.Body[0]
+	var neck *Point
+	if len(snake.Body) >= 2 {
+		neck = &snake.Body[1]
+	}
+
+	wraps := false
+	if w, ok := r.(BoardWrapper); ok {
+		wraps = w.WrapsBoard()
+	}
+
+	candidates := []Move{MoveUp, MoveDown, MoveLeft, MoveRight}
+	moves := make([]SnakeMove, 0, len(candidates))
+	for _, move := range candidates {
+		next := head
+		switch move {
+		case MoveUp:
+			next.Y--
+		case MoveDown:
+			next.Y++
+		case MoveLeft:
+			next.X--
+		case MoveRight:
+			next.X++
+		}
+
+		outOfBounds := next.X < 0 || next.X >= b.Width || next.Y < 0 || next.Y >= b.Height
+		if outOfBounds {
+			if !wraps {
+				continue
+			}
+			next.X = (next.X + b.Width) % b.Width
+			next.Y = (next.Y + b.Height) % b.Height
+		}
+		if neck != nil && next.X == neck.X && next.Y == neck.Y {
+			continue
+		}
+		moves = append(moves, SnakeMove{ID: snakeID, Move: move})
+	}
+	return moves
+}
+
+// Simulate advances b by one turn under r, without modifying b. It is a
+// thin, named entry point over Ruleset.CreateNextBoardState for simulation
+// callers, so that MCTS-style agents have a single obvious function to
+// call per hypothetical branch.
+func Simulate(r Ruleset, b *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error) {
+	return r.CreateNextBoardState(b, moves)
+}
+
+// Rollout plays a game forward from b under r, using policy to choose each
+// living snake's move every turn, until the game ends or maxTurns is
+// reached. It returns the final BoardState and the number of turns played.
+func Rollout(r Ruleset, b *BoardState, policy func(*BoardState, string) Move, maxTurns int) (*BoardState, int, error) {
+	state := b
+	for turn := 0; turn < maxTurns; turn++ {
+		over, err := r.IsGameOver(state)
+		if err != nil {
+			return nil, turn, err
+		}
+		if over {
+			return state, turn, nil
+		}
+
+		moves := make([]SnakeMove, 0, len(state.Snakes))
+		for _, snake := range state.Snakes {
+			if snake.EliminatedCause != NotEliminated {
+				moves = append(moves, SnakeMove{ID: snake.ID, Move: MoveUp})
+				continue
+			}
+			moves = append(moves, SnakeMove{ID: snake.ID, Move: policy(state, snake.ID)})
+		}
+
+		next, _, err := r.CreateNextBoardState(state, moves)
+		if err != nil {
+			return nil, turn, err
+		}
+		state = next
+	}
+	return state, maxTurns, nil
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+)
+
+func benchmarkSnakeIDs() []string {
+	return []string{"a", "b", "c", "d"}
+}
+
+func TestPossibleMovesExcludesNeckAndOutOfBounds(t *testing.T) {
+	r := &StandardRuleset{}
+	board := &BoardState{
+		Width:  3,
+		Height: 3,
+		Snakes: []Snake{{ID: "s", Body: []Point{{0, 0}, {0, 1}}}},
+	}
+
+	got := map[Move]bool{}
+	for _, m := range PossibleMoves(r, board, "s") {
+		got[m.Move] = true
+	}
+
+	if got[MoveUp] || got[MoveLeft] {
+		t.Errorf("PossibleMoves returned out-of-bounds moves: %v", got)
+	}
+	if got[MoveDown] {
+		t.Errorf("PossibleMoves returned a move back into the snake's own neck: %v", got)
+	}
+	if !got[MoveRight] {
+		t.Errorf("PossibleMoves excluded a valid move: %v", got)
+	}
+}
+
+func TestPossibleMovesAllowsWrappingOffEdge(t *testing.T) {
+	r := NewWrappedRuleset()
+	board := &BoardState{
+		Width:  3,
+		Height: 3,
+		Snakes: []Snake{{ID: "s", Body: []Point{{0, 1}, {1, 1}}}},
+	}
+
+	for _, m := range PossibleMoves(r, board, "s") {
+		if m.Move == MoveLeft {
+			return
+		}
+	}
+	t.Error("expected MoveLeft to be possible on a wrapping ruleset, even though it crosses the edge")
+}
+
+// BenchmarkSimulate reports simulations/second on an 11x11 board with 4
+// snakes, so agent authors can budget their per-turn search depth.
+func BenchmarkSimulate(b *testing.B) {
+	ruleset := WithSeed(42)
+	board, err := ruleset.CreateInitialBoardState(BoardSizeMedium, BoardSizeMedium, benchmarkSnakeIDs())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	moves := make([]SnakeMove, len(board.Snakes))
+	for i, snake := range board.Snakes {
+		moves[i] = SnakeMove{ID: snake.ID, Move: MoveUp}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := Simulate(ruleset, board, moves); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkRollout reports full-game rollouts/second on an 11x11 board with
+// 4 snakes, always moving up (snakes eliminate quickly, but the allocation
+// and collision-checking profile is representative).
+func BenchmarkRollout(b *testing.B) {
+	ruleset := WithSeed(42)
+	board, err := ruleset.CreateInitialBoardState(BoardSizeMedium, BoardSizeMedium, benchmarkSnakeIDs())
+	if err != nil {
+		b.Fatal(err)
+	}
+	policy := func(*BoardState, string) Move { return MoveUp }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := Rollout(ruleset, board, policy, 100); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package rules
+
+import "errors"
+
+// SquadRulesetOptions configures squad behavior for SquadRuleset.
+type SquadRulesetOptions struct {
+	// SharedElimination, if true, eliminates an entire squad as soon as
+	// any one if its members is eliminated.
+	SharedElimination bool
+}
+
+// SquadRuleset groups snakes into squads (by Snake.Squad) that can share
+// elimination and are immune to colliding with their own teammates.
+type SquadRuleset struct {
+	StandardRuleset
+	Options SquadRulesetOptions
+}
+
+// NewSquadRuleset constructs a SquadRuleset with the given options.
+func NewSquadRuleset(options SquadRulesetOptions) *SquadRuleset {
+	return &SquadRuleset{Options: options}
+}
+
+// Name implements Ruleset.
+func (r *SquadRuleset) Name() string { return "squad" }
+
+func (r *SquadRuleset) CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error) {
+	nextState := nextBoardStateSkeleton(prevState)
+
+	var events []TurnEvent
+
+	moveEvents, err := r.moveSnakes(nextState, moves)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, moveEvents...)
+
+	if err := r.reduceSnakeHealth(nextState); err != nil {
+		return nil, nil, err
+	}
+
+	feedEvents, err := r.maybeFeedSnakes(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, feedEvents...)
+
+	spawnEvents, err := r.maybeSpawnFood(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, spawnEvents...)
+
+	eliminateEvents, err := r.maybeEliminateSnakes(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, eliminateEvents...)
+
+	if r.Options.SharedElimination {
+		events = append(events, r.eliminateSquads(nextState)...)
+	}
+
+	return nextState, events, nil
+}
+
+// maybeEliminateSnakes mirrors StandardRuleset's method of the same name,
+// except that body and head-to-head collisions between squadmates never
+// eliminate either snake: squadmates are always immune to colliding with
+// their own teammates, regardless of any option. Snakes with an empty Squad
+// are not anyone's teammates. Unlike StandardRuleset, other snakes are
+// checked in board order rather than longest first, so in a multi-snake
+// collision EliminatedBy names the first such snake in b.Snakes.
+func (r *SquadRuleset) maybeEliminateSnakes(b *BoardState) ([]TurnEvent, error) {
+	var events []TurnEvent
+	for i := 0; i < len(b.Snakes); i++ {
+		snake := &b.Snakes[i]
+		if len(snake.Body) <= 0 {
+			return nil, errors.New("snake is length zero")
+		}
+
+		// Record head-to-head contact regardless of outcome, for replay/analytics.
+		for j := 0; j < len(b.Snakes); j++ {
+			other := &b.Snakes[j]
+			if snake.ID != other.ID && len(other.Body) > 0 && snake.Body[0] == other.Body[0] {
+				events = append(events, HeadToHeadEvent{SnakeID: snake.ID, OtherID: other.ID})
+			}
+		}
+
+		if r.snakeHasStarved(snake) {
+			snake.EliminatedCause = EliminatedByStarvation
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause})
+			continue
+		}
+		if r.snakeIsOutOfBounds(snake, b.Width, b.Height) {
+			snake.EliminatedCause = EliminatedByOutOfBounds
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause})
+			continue
+		}
+		if r.snakeHasBodyCollided(snake, snake) {
+			snake.EliminatedCause = EliminatedBySelfCollision
+			snake.EliminatedBy = snake.ID
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause, By: snake.EliminatedBy})
+			continue
+		}
+		for j := 0; j < len(b.Snakes); j++ {
+			other := &b.Snakes[j]
+			if snake.ID == other.ID || (snake.Squad != "" && snake.Squad == other.Squad) {
+				continue
+			}
+			if r.snakeHasBodyCollided(snake, other) {
+				snake.EliminatedCause = EliminatedByCollision
+				snake.EliminatedBy = other.ID
+				break
+			}
+		}
+		if snake.EliminatedCause != NotEliminated {
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause, By: snake.EliminatedBy})
+			continue
+		}
+		for j := 0; j < len(b.Snakes); j++ {
+			other := &b.Snakes[j]
+			if snake.ID == other.ID || (snake.Squad != "" && snake.Squad == other.Squad) {
+				continue
+			}
+			if r.snakeHasLostHeadToHead(snake, other) {
+				snake.EliminatedCause = EliminatedByHeadToHeadCollision
+				snake.EliminatedBy = other.ID
+				events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause, By: snake.EliminatedBy})
+				break
+			}
+		}
+	}
+	return events, nil
+}
+
+// eliminateSquads eliminates every member of a squad once any one of its
+// members has been eliminated, so that squads win or lose as a unit.
+func (r *SquadRuleset) eliminateSquads(b *BoardState) []TurnEvent {
+	eliminatedSquads := map[string]string{}
+	for i := 0; i < len(b.Snakes); i++ {
+		snake := &b.Snakes[i]
+		if snake.Squad == "" || snake.EliminatedCause == NotEliminated {
+			continue
+		}
+		if _, ok := eliminatedSquads[snake.Squad]; !ok {
+			eliminatedSquads[snake.Squad] = snake.EliminatedCause
+		}
+	}
+	var events []TurnEvent
+	for i := 0; i < len(b.Snakes); i++ {
+		snake := &b.Snakes[i]
+		if snake.EliminatedCause != NotEliminated {
+			continue
+		}
+		if cause, ok := eliminatedSquads[snake.Squad]; ok {
+			snake.EliminatedCause = cause
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: cause})
+		}
+	}
+	return events
+}
+
+// IsGameOver implements Ruleset, treating a squad as a single unit: the
+// game ends once at most one squad (or un-squadded snake) remains alive.
+func (r *SquadRuleset) IsGameOver(b *BoardState) (bool, error) {
+	remaining := map[string]bool{}
+	for i := 0; i < len(b.Snakes); i++ {
+		snake := &b.Snakes[i]
+		if snake.EliminatedCause != NotEliminated {
+			continue
+		}
+		if snake.Squad != "" {
+			remaining[snake.Squad] = true
+		} else {
+			remaining[snake.ID] = true
+		}
+	}
+	return len(remaining) <= 1, nil
+}
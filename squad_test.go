@@ -0,0 +1,66 @@
+package rules
+
+import "testing"
+
+func TestSquadRulesetSharedElimination(t *testing.T) {
+	r := NewSquadRuleset(SquadRulesetOptions{SharedElimination: true})
+	board := &BoardState{
+		Width:  7,
+		Height: 7,
+		Snakes: []Snake{
+			{ID: "a", Squad: "red", Health: 1, Body: []Point{{1, 1}}},
+			{ID: "b", Squad: "red", Health: SnakeMaxHealth, Body: []Point{{5, 5}}},
+		},
+	}
+	moves := []SnakeMove{{ID: "a", Move: MoveUp}, {ID: "b", Move: MoveUp}}
+
+	next, events, err := r.CreateNextBoardState(board, moves)
+	if err != nil {
+		t.Fatalf("CreateNextBoardState: %v", err)
+	}
+
+	if next.Snakes[0].EliminatedCause != EliminatedByStarvation {
+		t.Fatalf("squadmate a: EliminatedCause = %q, want starvation", next.Snakes[0].EliminatedCause)
+	}
+	if next.Snakes[1].EliminatedCause != EliminatedByStarvation {
+		t.Errorf("squadmate b should share a's elimination, EliminatedCause = %q", next.Snakes[1].EliminatedCause)
+	}
+
+	found := false
+	for _, e := range events {
+		if se, ok := e.(SnakeEliminatedEvent); ok && se.SnakeID == "b" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected a SnakeEliminatedEvent for squadmate b")
+	}
+}
+
+func TestSquadRulesetAllowsBodyCollisionsWithinSquad(t *testing.T) {
+	// Squadmate collision immunity is unconditional, so a zero-value
+	// SquadRuleset{} (the common construction path) must deliver it too.
+	r := &SquadRuleset{}
+	board := &BoardState{
+		Width:  7,
+		Height: 7,
+		Snakes: []Snake{
+			{ID: "a", Squad: "red", Health: SnakeMaxHealth, Body: []Point{{2, 2}, {3, 2}}},
+			{ID: "b", Squad: "red", Health: SnakeMaxHealth, Body: []Point{{3, 2}, {3, 3}}},
+		},
+	}
+
+	events, err := r.maybeEliminateSnakes(board)
+	if err != nil {
+		t.Fatalf("maybeEliminateSnakes: %v", err)
+	}
+	if board.Snakes[0].EliminatedCause != NotEliminated {
+		t.Errorf("squadmate a collided with squadmate b's body, want no elimination, got %q", board.Snakes[0].EliminatedCause)
+	}
+	if board.Snakes[1].EliminatedCause != NotEliminated {
+		t.Errorf("squadmate b's head landed on squadmate a's body, want no elimination, got %q", board.Snakes[1].EliminatedCause)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no elimination events, got %v", events)
+	}
+}
@@ -6,7 +6,70 @@ import (
 	"sort"
 )
 
-type StandardRuleset struct{}
+// StandardRuleset implements the classic Battlesnake rules: snakes move,
+// lose health each turn, eat food to grow and heal, and are eliminated by
+// starvation, collisions, or leaving the board.
+//
+// A *StandardRuleset is not safe for concurrent use: Rand and the internal
+// scratch buffer used by getUnoccupiedPoints are both mutated by
+// CreateInitialBoardState/CreateNextBoardState (and so by Simulate/Rollout).
+// A search agent parallelizing across goroutines needs one ruleset instance
+// per goroutine, e.g. WithSeed(seed) called once per worker.
+type StandardRuleset struct {
+	// Rand is the source of randomness used for snake/food placement and
+	// food spawning. If nil, the global math/rand functions are used,
+	// which is convenient for a zero-value StandardRuleset{} but not
+	// reproducible. Set Rand (or use WithSeed) for deterministic replays
+	// and tests.
+	Rand *rand.Rand
+
+	// occupiedScratch is a reusable scratch buffer for getUnoccupiedPoints,
+	// so that simulating many turns (e.g. via Simulate/Rollout) doesn't
+	// allocate a fresh occupancy map on every call.
+	occupiedScratch []bool
+
+	// FoodVariants registers the kinds of food that can spawn on the
+	// board, each with its own spawn weight, growth, healing, and point
+	// value. A nil/empty registry reproduces classic food behavior via
+	// defaultFoodVariant.
+	FoodVariants []FoodVariant
+}
+
+// WithSeed returns a StandardRuleset whose randomness is seeded
+// deterministically, so that CreateInitialBoardState and
+// CreateNextBoardState produce the same sequence of placements given the
+// same inputs every time.
+func WithSeed(seed int64) *StandardRuleset {
+	return &StandardRuleset{Rand: rand.New(rand.NewSource(seed))}
+}
+
+// Name implements Ruleset.
+func (r *StandardRuleset) Name() string { return "standard" }
+
+// randFloat32 and friends dispatch to r.Rand when set, falling back to the
+// global math/rand source otherwise.
+
+func (r *StandardRuleset) randFloat32() float32 {
+	if r.Rand != nil {
+		return r.Rand.Float32()
+	}
+	return rand.Float32()
+}
+
+func (r *StandardRuleset) randIntn(n int) int {
+	if r.Rand != nil {
+		return r.Rand.Intn(n)
+	}
+	return rand.Intn(n)
+}
+
+func (r *StandardRuleset) randShuffle(n int, swap func(i, j int)) {
+	if r.Rand != nil {
+		r.Rand.Shuffle(n, swap)
+		return
+	}
+	rand.Shuffle(n, swap)
+}
 
 const (
 	BoardSizeSmall  = 7
@@ -83,7 +146,7 @@ func (r *StandardRuleset) placeSnakesFixed(b *BoardState) error {
 	}
 
 	// Randomly order them
-	rand.Shuffle(len(startPoints), func(i int, j int) {
+	r.randShuffle(len(startPoints), func(i int, j int) {
 		startPoints[i], startPoints[j] = startPoints[j], startPoints[i]
 	})
 
@@ -104,7 +167,7 @@ func (r *StandardRuleset) placeSnakesRandomly(b *BoardState) error {
 		if len(unoccupiedPoints) <= 0 {
 			return errors.New("not enough space to place snake")
 		}
-		p := unoccupiedPoints[rand.Intn(len(unoccupiedPoints))]
+		p := unoccupiedPoints[r.randIntn(len(unoccupiedPoints))]
 		for j := 0; j < SnakeStartSize; j++ {
 			b.Snakes[i].Body = append(b.Snakes[i].Body, p)
 		}
@@ -150,8 +213,8 @@ func (r *StandardRuleset) placeFoodFixed(b *BoardState) error {
 		}
 
 		// Select randomly from available locations
-		placedFood := availableFoodLocations[rand.Intn(len(availableFoodLocations))]
-		b.Food = append(b.Food, placedFood)
+		placedFood := availableFoodLocations[r.randIntn(len(availableFoodLocations))]
+		b.Food = append(b.Food, Food{Point: placedFood, Variant: DefaultFoodVariant})
 	}
 
 	// Finally, always place 1 food in center of board for dramatic purposes
@@ -167,13 +230,14 @@ func (r *StandardRuleset) placeFoodFixed(b *BoardState) error {
 	if isCenterOccupied {
 		return errors.New("not enough space to place food")
 	}
-	b.Food = append(b.Food, centerCoord)
+	b.Food = append(b.Food, Food{Point: centerCoord, Variant: DefaultFoodVariant})
 
 	return nil
 }
 
 func (r *StandardRuleset) placeFoodRandomly(b *BoardState) error {
-	return r.spawnFood(b, len(b.Snakes))
+	_, err := r.spawnFood(b, len(b.Snakes))
+	return err
 }
 
 func (r *StandardRuleset) isKnownBoardSize(b *BoardState) bool {
@@ -189,73 +253,64 @@ func (r *StandardRuleset) isKnownBoardSize(b *BoardState) bool {
 	return false
 }
 
-func (r *StandardRuleset) CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, error) {
+func (r *StandardRuleset) CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error) {
 	// We specifically want to copy prevState, so as not to alter it directly.
-	nextState := &BoardState{
-		Height: prevState.Height,
-		Width:  prevState.Width,
-		Food:   append([]Point{}, prevState.Food...),
-		Snakes: make([]Snake, len(prevState.Snakes)),
-	}
-	for i := 0; i < len(prevState.Snakes); i++ {
-		nextState.Snakes[i].ID = prevState.Snakes[i].ID
-		nextState.Snakes[i].Health = prevState.Snakes[i].Health
-		nextState.Snakes[i].Body = append([]Point{}, prevState.Snakes[i].Body...)
-	}
+	nextState := nextBoardStateSkeleton(prevState)
 
 	// TODO: Gut check the BoardState?
 
-	// TODO: LOG?
-	err := r.moveSnakes(nextState, moves)
+	var events []TurnEvent
+
+	moveEvents, err := r.moveSnakes(nextState, moves)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	events = append(events, moveEvents...)
 
-	// TODO: LOG?
-	err = r.reduceSnakeHealth(nextState)
-	if err != nil {
-		return nil, err
+	if err := r.reduceSnakeHealth(nextState); err != nil {
+		return nil, nil, err
 	}
 
-	// TODO: LOG?
 	// bvanvugt: We specifically want this to happen before elimination for two reasons:
 	// 1) We want snakes to be able to eat on their very last turn and still survive.
 	// 2) So that head-to-head collisions on food still remove the food.
 	//    This does create an artifact though, where head-to-head collisions
 	//    of equal length actually show length + 1 and full health, as if both snakes ate.
-	err = r.maybeFeedSnakes(nextState)
+	feedEvents, err := r.maybeFeedSnakes(nextState)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	events = append(events, feedEvents...)
 
-	// TODO: LOG?
-	err = r.maybeSpawnFood(nextState)
+	spawnEvents, err := r.maybeSpawnFood(nextState)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	events = append(events, spawnEvents...)
 
-	// TODO: LOG?
-	err = r.maybeEliminateSnakes(nextState)
+	eliminateEvents, err := r.maybeEliminateSnakes(nextState)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	events = append(events, eliminateEvents...)
 
-	return nextState, nil
+	return nextState, events, nil
 }
 
-func (r *StandardRuleset) moveSnakes(b *BoardState, moves []SnakeMove) error {
+func (r *StandardRuleset) moveSnakes(b *BoardState, moves []SnakeMove) ([]TurnEvent, error) {
 	for i := 0; i < len(b.Snakes); i++ {
 		if len(b.Snakes[i].Body) == 0 {
-			return errors.New("found snake with zero size body")
+			return nil, errors.New("found snake with zero size body")
 		}
 	}
 	if len(moves) < len(b.Snakes) {
-		return errors.New("not enough snake moves")
+		return nil, errors.New("not enough snake moves")
 	}
 	if len(moves) > len(b.Snakes) {
-		return errors.New("too many snake moves")
+		return nil, errors.New("too many snake moves")
 	}
 
+	var events []TurnEvent
 	for _, move := range moves {
 		var snake *Snake
 		for i := 0; i < len(b.Snakes); i++ {
@@ -264,7 +319,7 @@ func (r *StandardRuleset) moveSnakes(b *BoardState, moves []SnakeMove) error {
 			}
 		}
 		if snake == nil {
-			return errors.New("snake not found for move")
+			return nil, errors.New("snake not found for move")
 		}
 
 		// Do not move eliminated snakes
@@ -305,8 +360,9 @@ func (r *StandardRuleset) moveSnakes(b *BoardState, moves []SnakeMove) error {
 
 		// Append new head, pop old tail
 		snake.Body = append([]Point{newHead}, snake.Body[:len(snake.Body)-1]...)
+		events = append(events, SnakeMovedEvent{SnakeID: snake.ID, Head: newHead})
 	}
-	return nil
+	return events, nil
 }
 
 func (r *StandardRuleset) reduceSnakeHealth(b *BoardState) error {
@@ -318,7 +374,7 @@ func (r *StandardRuleset) reduceSnakeHealth(b *BoardState) error {
 	return nil
 }
 
-func (r *StandardRuleset) maybeEliminateSnakes(b *BoardState) error {
+func (r *StandardRuleset) maybeEliminateSnakes(b *BoardState) ([]TurnEvent, error) {
 	// First order snake indices by length.
 	// In multi-collision scenarios we want to always attribute elimination to the longest snake.
 	snakeIndicesByLength := make([]int, len(b.Snakes))
@@ -331,20 +387,35 @@ func (r *StandardRuleset) maybeEliminateSnakes(b *BoardState) error {
 		return lenI > lenJ
 	})
 
+	var events []TurnEvent
+
 	// Iterate through snakes checking for eliminations.
 	for i := 0; i < len(b.Snakes); i++ {
 		snake := &b.Snakes[i]
 		if len(snake.Body) <= 0 {
-			return errors.New("snake is length zero")
+			return nil, errors.New("snake is length zero")
+		}
+
+		// Record head-to-head contact regardless of outcome, for replay/analytics.
+		for _, otherIndex := range snakeIndicesByLength {
+			other := &b.Snakes[otherIndex]
+			if snake.ID == other.ID {
+				continue
+			}
+			if snake.Body[0].X == other.Body[0].X && snake.Body[0].Y == other.Body[0].Y {
+				events = append(events, HeadToHeadEvent{SnakeID: snake.ID, OtherID: other.ID})
+			}
 		}
 
 		if r.snakeHasStarved(snake) {
 			snake.EliminatedCause = EliminatedByStarvation
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause})
 			continue
 		}
 
 		if r.snakeIsOutOfBounds(snake, b.Width, b.Height) {
 			snake.EliminatedCause = EliminatedByOutOfBounds
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause})
 			continue
 		}
 
@@ -352,6 +423,7 @@ func (r *StandardRuleset) maybeEliminateSnakes(b *BoardState) error {
 		if r.snakeHasBodyCollided(snake, snake) {
 			snake.EliminatedCause = EliminatedBySelfCollision
 			snake.EliminatedBy = snake.ID
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause, By: snake.EliminatedBy})
 			continue
 		}
 
@@ -368,6 +440,7 @@ func (r *StandardRuleset) maybeEliminateSnakes(b *BoardState) error {
 			}
 		}
 		if snake.EliminatedCause != NotEliminated {
+			events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause, By: snake.EliminatedBy})
 			continue
 		}
 
@@ -377,11 +450,12 @@ func (r *StandardRuleset) maybeEliminateSnakes(b *BoardState) error {
 			if snake.ID != other.ID && r.snakeHasLostHeadToHead(snake, other) {
 				snake.EliminatedCause = EliminatedByHeadToHeadCollision
 				snake.EliminatedBy = other.ID
+				events = append(events, SnakeEliminatedEvent{SnakeID: snake.ID, Cause: snake.EliminatedCause, By: snake.EliminatedBy})
 				break
 			}
 		}
 	}
-	return nil
+	return events, nil
 }
 
 func (r *StandardRuleset) snakeHasStarved(s *Snake) bool {
@@ -419,8 +493,9 @@ func (r *StandardRuleset) snakeHasLostHeadToHead(s *Snake, other *Snake) bool {
 	return false
 }
 
-func (r *StandardRuleset) maybeFeedSnakes(b *BoardState) error {
-	newFood := []Point{}
+func (r *StandardRuleset) maybeFeedSnakes(b *BoardState) ([]TurnEvent, error) {
+	var events []TurnEvent
+	newFood := []Food{}
 	for _, food := range b.Food {
 		foodHasBeenEaten := false
 		for i := 0; i < len(b.Snakes); i++ {
@@ -432,7 +507,12 @@ func (r *StandardRuleset) maybeFeedSnakes(b *BoardState) error {
 			}
 
 			if snake.Body[0].X == food.X && snake.Body[0].Y == food.Y {
-				r.feedSnake(snake)
+				variant := r.foodVariant(food.Variant)
+				r.feedSnake(snake, variant)
+				events = append(events,
+					SnakeAteEvent{SnakeID: snake.ID, Food: food.Point, Variant: food.Variant},
+					SnakeGrewEvent{SnakeID: snake.ID, Amount: variant.Growth},
+				)
 				foodHasBeenEaten = true
 			}
 		}
@@ -443,66 +523,96 @@ func (r *StandardRuleset) maybeFeedSnakes(b *BoardState) error {
 	}
 
 	b.Food = newFood
-	return nil
+	return events, nil
 }
 
-func (r *StandardRuleset) feedSnake(snake *Snake) {
-	r.growSnake(snake)
-	snake.Health = SnakeMaxHealth
+func (r *StandardRuleset) feedSnake(snake *Snake, variant FoodVariant) {
+	r.growSnake(snake, variant.Growth)
+	snake.Health = variant.HealHealth
+	snake.Score += variant.Points
 }
 
-func (r *StandardRuleset) growSnake(snake *Snake) {
-	if len(snake.Body) > 0 {
-		snake.Body = append(snake.Body, snake.Body[len(snake.Body)-1])
+// growSnake changes a snake's length by amount segments: positive amount
+// repeats its tail segment, negative amount (e.g. a "poison" FoodVariant)
+// removes segments from the tail, down to a minimum length of 1.
+func (r *StandardRuleset) growSnake(snake *Snake, amount int32) {
+	if len(snake.Body) == 0 {
+		return
+	}
+	if amount >= 0 {
+		tail := snake.Body[len(snake.Body)-1]
+		for i := int32(0); i < amount; i++ {
+			snake.Body = append(snake.Body, tail)
+		}
+		return
+	}
+	for i := int32(0); i < -amount && len(snake.Body) > 1; i++ {
+		snake.Body = snake.Body[:len(snake.Body)-1]
 	}
 }
 
-func (r *StandardRuleset) maybeSpawnFood(b *BoardState) error {
-	if len(b.Food) == 0 || rand.Float32() <= FoodSpawnChance {
+func (r *StandardRuleset) maybeSpawnFood(b *BoardState) ([]TurnEvent, error) {
+	if len(b.Food) == 0 || r.randFloat32() <= FoodSpawnChance {
 		return r.spawnFood(b, 1)
 	}
-	return nil
+	return nil, nil
 }
 
-func (r *StandardRuleset) spawnFood(b *BoardState, n int) error {
+func (r *StandardRuleset) spawnFood(b *BoardState, n int) ([]TurnEvent, error) {
+	var events []TurnEvent
 	for i := 0; i < n; i++ {
 		unoccupiedPoints := r.getUnoccupiedPoints(b)
 		if len(unoccupiedPoints) > 0 {
-			newFood := unoccupiedPoints[rand.Intn(len(unoccupiedPoints))]
+			variant, err := r.pickFoodVariant()
+			if err != nil {
+				return nil, err
+			}
+			newFood := Food{
+				Point:   unoccupiedPoints[r.randIntn(len(unoccupiedPoints))],
+				Variant: variant.ID,
+			}
 			b.Food = append(b.Food, newFood)
+			events = append(events, FoodSpawnedEvent{Food: newFood.Point, Variant: newFood.Variant})
 		}
 	}
-	return nil
+	return events, nil
 }
 
+// getUnoccupiedPoints returns every point on the board not covered by food
+// or a snake body. occupiedScratch is reused across calls (growing as
+// needed) so that repeated calls, as from Simulate/Rollout, don't churn the
+// allocator with a fresh map on every turn.
 func (r *StandardRuleset) getUnoccupiedPoints(b *BoardState) []Point {
-	pointIsOccupied := map[int32]map[int32]bool{}
-	for _, p := range b.Food {
-		if _, xExists := pointIsOccupied[p.X]; !xExists {
-			pointIsOccupied[p.X] = map[int32]bool{}
+	size := int(b.Width) * int(b.Height)
+	if cap(r.occupiedScratch) < size {
+		r.occupiedScratch = make([]bool, size)
+	}
+	occupied := r.occupiedScratch[:size]
+	for i := range occupied {
+		occupied[i] = false
+	}
+
+	markOccupied := func(p Point) {
+		if p.X < 0 || p.X >= b.Width || p.Y < 0 || p.Y >= b.Height {
+			return
 		}
-		pointIsOccupied[p.X][p.Y] = true
+		occupied[int(p.X)*int(b.Height)+int(p.Y)] = true
+	}
+	for _, food := range b.Food {
+		markOccupied(food.Point)
 	}
 	for _, snake := range b.Snakes {
 		for _, p := range snake.Body {
-			if _, xExists := pointIsOccupied[p.X]; !xExists {
-				pointIsOccupied[p.X] = map[int32]bool{}
-			}
-			pointIsOccupied[p.X][p.Y] = true
+			markOccupied(p)
 		}
 	}
 
 	unoccupiedPoints := []Point{}
 	for x := int32(0); x < b.Width; x++ {
 		for y := int32(0); y < b.Height; y++ {
-			if _, xExists := pointIsOccupied[x]; xExists {
-				if isOccupied, yExists := pointIsOccupied[x][y]; yExists {
-					if isOccupied {
-						continue
-					}
-				}
+			if !occupied[int(x)*int(b.Height)+int(y)] {
+				unoccupiedPoints = append(unoccupiedPoints, Point{X: x, Y: y})
 			}
-			unoccupiedPoints = append(unoccupiedPoints, Point{X: x, Y: y})
 		}
 	}
 	return unoccupiedPoints
@@ -0,0 +1,125 @@
+package rules
+
+import "errors"
+
+// WrappedRuleset is the Battlesnake Wrapped variant: snakes that move off
+// one edge of the board reappear on the opposite edge instead of being
+// eliminated for leaving the board.
+type WrappedRuleset struct {
+	StandardRuleset
+}
+
+// NewWrappedRuleset constructs a WrappedRuleset.
+func NewWrappedRuleset() *WrappedRuleset {
+	return &WrappedRuleset{}
+}
+
+// Name implements Ruleset.
+func (r *WrappedRuleset) Name() string { return "wrapped" }
+
+// WrapsBoard implements BoardWrapper, so PossibleMoves knows not to prune
+// an edge-crossing move as out of bounds.
+func (r *WrappedRuleset) WrapsBoard() bool { return true }
+
+func (r *WrappedRuleset) CreateNextBoardState(prevState *BoardState, moves []SnakeMove) (*BoardState, []TurnEvent, error) {
+	nextState := nextBoardStateSkeleton(prevState)
+
+	var events []TurnEvent
+
+	moveEvents, err := r.wrappedMoveSnakes(nextState, moves)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, moveEvents...)
+
+	if err := r.reduceSnakeHealth(nextState); err != nil {
+		return nil, nil, err
+	}
+
+	feedEvents, err := r.maybeFeedSnakes(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, feedEvents...)
+
+	spawnEvents, err := r.maybeSpawnFood(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, spawnEvents...)
+
+	eliminateEvents, err := r.maybeEliminateSnakes(nextState)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, eliminateEvents...)
+
+	return nextState, events, nil
+}
+
+// wrappedMoveSnakes mirrors StandardRuleset.moveSnakes, but wraps the new
+// head position around the opposite edge of the board instead of letting
+// it go out of bounds.
+func (r *WrappedRuleset) wrappedMoveSnakes(b *BoardState, moves []SnakeMove) ([]TurnEvent, error) {
+	for i := 0; i < len(b.Snakes); i++ {
+		if len(b.Snakes[i].Body) == 0 {
+			return nil, errors.New("found snake with zero size body")
+		}
+	}
+	if len(moves) < len(b.Snakes) {
+		return nil, errors.New("not enough snake moves")
+	}
+	if len(moves) > len(b.Snakes) {
+		return nil, errors.New("too many snake moves")
+	}
+
+	var events []TurnEvent
+	for _, move := range moves {
+		var snake *Snake
+		for i := 0; i < len(b.Snakes); i++ {
+			if b.Snakes[i].ID == move.ID {
+				snake = &b.Snakes[i]
+			}
+		}
+		if snake == nil {
+			return nil, errors.New("snake not found for move")
+		}
+		if snake.EliminatedCause != NotEliminated {
+			continue
+		}
+
+		newHead := r.nextHead(snake, move.Move)
+		newHead.X = (newHead.X + b.Width) % b.Width
+		newHead.Y = (newHead.Y + b.Height) % b.Height
+
+		snake.Body = append([]Point{newHead}, snake.Body[:len(snake.Body)-1]...)
+		events = append(events, SnakeMovedEvent{SnakeID: snake.ID, Head: newHead})
+	}
+	return events, nil
+}
+
+// nextHead computes the unwrapped point a snake's head moves to for the
+// given move, defaulting to its last direction of travel (or up).
+func (r *WrappedRuleset) nextHead(snake *Snake, move Move) Point {
+	head := snake.Body[0]
+	switch move {
+	case MoveDown:
+		return Point{X: head.X, Y: head.Y + 1}
+	case MoveLeft:
+		return Point{X: head.X - 1, Y: head.Y}
+	case MoveRight:
+		return Point{X: head.X + 1, Y: head.Y}
+	case MoveUp:
+		return Point{X: head.X, Y: head.Y - 1}
+	default:
+		var dX, dY int32 = 0, -1
+		if len(snake.Body) >= 2 {
+			dX = snake.Body[0].X - snake.Body[1].X
+			dY = snake.Body[0].Y - snake.Body[1].Y
+			if dX == 0 && dY == 0 {
+				dY = -1
+			}
+		}
+		return Point{X: head.X + dX, Y: head.Y + dY}
+	}
+}
@@ -0,0 +1,33 @@
+package rules
+
+import "testing"
+
+func TestWrappedRulesetWrapsAroundEdges(t *testing.T) {
+	r := NewWrappedRuleset()
+	board := &BoardState{
+		Width:  5,
+		Height: 5,
+		Snakes: []Snake{{ID: "s", Health: SnakeMaxHealth, Body: []Point{{0, 2}, {0, 2}, {0, 2}}}},
+	}
+
+	next, _, err := r.CreateNextBoardState(board, []SnakeMove{{ID: "s", Move: MoveLeft}})
+	if err != nil {
+		t.Fatalf("CreateNextBoardState: %v", err)
+	}
+
+	want := Point{X: board.Width - 1, Y: 2}
+	if got := next.Snakes[0].Body[0]; got != want {
+		t.Errorf("head wrapped to %v, want %v", got, want)
+	}
+	if next.Snakes[0].EliminatedCause != NotEliminated {
+		t.Errorf("wrapping off the edge should not eliminate the snake, got %q", next.Snakes[0].EliminatedCause)
+	}
+}
+
+func TestWrappedRulesetImplementsBoardWrapper(t *testing.T) {
+	var r Ruleset = NewWrappedRuleset()
+	w, ok := r.(BoardWrapper)
+	if !ok || !w.WrapsBoard() {
+		t.Error("WrappedRuleset should implement BoardWrapper and report WrapsBoard() == true")
+	}
+}